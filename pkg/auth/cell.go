@@ -4,6 +4,7 @@
 package auth
 
 import (
+	"context"
 	"fmt"
 	"runtime/pprof"
 	"time"
@@ -11,16 +12,17 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
 
+	"github.com/cilium/cilium/pkg/auth/certs"
+	"github.com/cilium/cilium/pkg/auth/metrics"
 	"github.com/cilium/cilium/pkg/auth/spire"
 	"github.com/cilium/cilium/pkg/hive"
 	"github.com/cilium/cilium/pkg/hive/cell"
 	"github.com/cilium/cilium/pkg/hive/job"
 	"github.com/cilium/cilium/pkg/identity/cache"
 	"github.com/cilium/cilium/pkg/ipcache"
-	"github.com/cilium/cilium/pkg/k8s"
-	ciliumv2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
-	"github.com/cilium/cilium/pkg/k8s/resource"
 	"github.com/cilium/cilium/pkg/maps/authmap"
+	monitorAgent "github.com/cilium/cilium/pkg/monitor/agent"
+	nodeManager "github.com/cilium/cilium/pkg/node/manager"
 	"github.com/cilium/cilium/pkg/policy"
 	"github.com/cilium/cilium/pkg/signal"
 	"github.com/cilium/cilium/pkg/stream"
@@ -36,6 +38,7 @@ var Cell = cell.Module(
 	"Authenticates requests as demanded by policy",
 
 	spire.Cell,
+	metrics.Cell,
 
 	// The auth manager is the main entry point which gets registered to signal map and receives auth requests.
 	// In addition, it handles re-authentication and auth map garbage collection.
@@ -46,12 +49,11 @@ var Cell = cell.Module(
 		// Always fail auth handler provides support for auth type "always-fail" - which always fails.
 		newAlwaysFailAuthHandler,
 	),
-	// Providing k8s resource Node & Identity privately to avoid further usage of them in other agent components
-	cell.ProvidePrivate(
-		// TODO: use node manager to get events of all nodes, including the ones of other clusters (ClusterMesh)
-		// https://github.com/cilium/cilium/issues/25899
-		k8s.CiliumNodeResource,
-	),
+	// JWT auth handler provides support for AuthTypeJWT, registered through
+	// the same RegisterAuthHandler extension point available to other
+	// cells whose handler is implemented in package auth.
+	RegisterAuthHandler("jwt", newJWTAuthHandler),
+	cell.Config(JWTConfig{}),
 	cell.Config(config{
 		MeshAuthEnabled:    true,
 		MeshAuthQueueSize:  1024,
@@ -86,8 +88,14 @@ type authManagerParams struct {
 	SignalManager   signal.SignalManager
 	IPCache         *ipcache.IPCache
 	IdentityChanges stream.Observable[cache.IdentityChange]
-	CiliumNodes     resource.Resource[*ciliumv2.CiliumNode]
+	NodeManager     nodeManager.NodeManager
 	PolicyRepo      *policy.Repository
+	Metrics         metrics.Metrics
+
+	// MonitorAgent is optional: builds that don't wire pkg/monitor/agent
+	// (e.g. some test hives) still start correctly, they just don't get
+	// authentication failures surfaced as hubble/monitor events.
+	MonitorAgent monitorAgent.Agent `optional:"true"`
 }
 
 func registerAuthManager(params authManagerParams) error {
@@ -105,8 +113,11 @@ func registerAuthManager(params authManagerParams) error {
 	if err != nil {
 		return fmt.Errorf("failed to create auth manager: %w", err)
 	}
+	params.Logger.WithField("authTypes", knownAuthTypes(params.AuthHandlers)).
+		Debug("Auth manager registered with the following auth types")
 
 	mapGC := newAuthMapGC(params.Logger, mapCache, params.IPCache, params.PolicyRepo)
+	nodeHandler := newAuthNodeHandler(params.Logger, mapGC, params.Metrics)
 
 	// Register auth components to lifecycle hooks & jobs
 
@@ -116,6 +127,16 @@ func registerAuthManager(params authManagerParams) error {
 				return fmt.Errorf("failed to restore auth map cache: %w", err)
 			}
 
+			// The node manager already aggregates nodes discovered locally
+			// via k8s as well as nodes of remote clusters synced through
+			// ClusterMesh, so auth map GC gets remote-cluster node
+			// deletions for free by subscribing here.
+			params.NodeManager.Subscribe(nodeHandler)
+
+			return nil
+		},
+		OnStop: func(hookContext hive.HookContext) error {
+			params.NodeManager.Unsubscribe(nodeHandler)
 			return nil
 		},
 	})
@@ -126,24 +147,29 @@ func registerAuthManager(params authManagerParams) error {
 	)
 	params.Lifecycle.Append(jobGroup)
 
-	if err := registerSignalAuthenticationJob(jobGroup, mgr, params.SignalManager, params.Config); err != nil {
+	if err := registerSignalAuthenticationJob(jobGroup, mgr, params.SignalManager, params.Config, params.Metrics, params.Logger, params.MonitorAgent, params.AuthHandlers); err != nil {
 		return fmt.Errorf("failed to register signal authentication job: %w", err)
 	}
-	registerReAuthenticationJob(jobGroup, mgr, params.AuthHandlers)
-	registerGCJobs(jobGroup, mapGC, params.Config, params.CiliumNodes, params.IdentityChanges)
+	registerReAuthenticationJob(jobGroup, mgr, params.AuthHandlers, params.Metrics)
+	registerGCJobs(jobGroup, mapGC, params.Config, params.IdentityChanges, params.Metrics)
 
 	return nil
 }
 
-func registerReAuthenticationJob(jobGroup job.Group, mgr *authManager, authHandlers []authHandler) {
+func registerReAuthenticationJob(jobGroup job.Group, mgr *authManager, authHandlers []authHandler, m metrics.Metrics) {
 	for _, ah := range authHandlers {
 		if ah != nil && ah.subscribeToRotatedIdentities() != nil {
-			jobGroup.Add(job.Observer("auth re-authentication", mgr.handleCertificateRotationEvent, stream.FromChannel(ah.subscribeToRotatedIdentities())))
+			authType := ah.authType()
+			handleRotation := func(ctx context.Context, ev certs.CertificateRotationEvent) error {
+				m.ReAuthenticationTotal.WithLabelValues(authType.String()).Inc()
+				return mgr.handleCertificateRotationEvent(ctx, ev)
+			}
+			jobGroup.Add(job.Observer("auth re-authentication", handleRotation, stream.FromChannel(ah.subscribeToRotatedIdentities())))
 		}
 	}
 }
 
-func registerSignalAuthenticationJob(jobGroup job.Group, mgr *authManager, sm signal.SignalManager, config config) error {
+func registerSignalAuthenticationJob(jobGroup job.Group, mgr *authManager, sm signal.SignalManager, config config, m metrics.Metrics, logger logrus.FieldLogger, monitor monitorAgent.Agent, authHandlers []authHandler) error {
 	var signalChannel = make(chan signalAuthKey, config.MeshAuthQueueSize)
 
 	// RegisterHandler registers signalChannel with SignalManager, but flow of events
@@ -152,20 +178,86 @@ func registerSignalAuthenticationJob(jobGroup job.Group, mgr *authManager, sm si
 		return fmt.Errorf("failed to set up signal channel for datapath authentication required events: %w", err)
 	}
 
-	jobGroup.Add(job.Observer("auth request-authentication", mgr.handleAuthRequest, stream.FromChannel(signalChannel)))
+	// knownTypes is fixed once the handler set is finalized at cell start,
+	// so a request for an auth type with no registered handler is rejected
+	// here rather than being handed to mgr.handleAuthRequest.
+	knownTypes := knownAuthTypes(authHandlers)
+
+	handleAuthRequest := func(ctx context.Context, key signalAuthKey) error {
+		m.SignalQueueSize.Set(float64(len(signalChannel)))
+
+		if _, ok := knownTypes[key.authType]; !ok {
+			err := rejectUnknownAuthType(m, key.authType)
+			m.RequestsTotal.WithLabelValues(key.authType.String(), "error").Inc()
+			emitAuthFailureEvent(logger, monitor, authFailureEvent{
+				AuthType:     key.authType,
+				SrcIdentity:  key.localIdentity,
+				DstIdentity:  key.remoteIdentity,
+				RemoteNodeIP: key.remoteNodeIP.String(),
+				Error:        err.Error(),
+			})
+			return err
+		}
+
+		start := time.Now()
+		err := mgr.handleAuthRequest(ctx, key)
+		m.RequestDuration.WithLabelValues(key.authType.String()).Observe(time.Since(start).Seconds())
+
+		outcome := "success"
+		if err != nil {
+			outcome = "error"
+			m.HandlerErrorsTotal.WithLabelValues(key.authType.String()).Inc()
+			emitAuthFailureEvent(logger, monitor, authFailureEvent{
+				AuthType:     key.authType,
+				SrcIdentity:  key.localIdentity,
+				DstIdentity:  key.remoteIdentity,
+				RemoteNodeIP: key.remoteNodeIP.String(),
+				Error:        err.Error(),
+			})
+		}
+		m.RequestsTotal.WithLabelValues(key.authType.String(), outcome).Inc()
+
+		return err
+	}
+	jobGroup.Add(job.Observer("auth request-authentication", handleAuthRequest, stream.FromChannel(signalChannel)))
 
 	return nil
 }
 
-func registerGCJobs(jobGroup job.Group, mapGC *authMapGarbageCollector, cfg config, nodeChanges resource.Resource[*ciliumv2.CiliumNode], identityChanges stream.Observable[cache.IdentityChange]) {
-	jobGroup.Add(job.Observer("auth gc-identity-events", mapGC.handleIdentityChange, identityChanges))
-
-	// Add node based auth gc if k8s client is enabled
-	if nodeChanges != nil {
-		jobGroup.Add(job.Observer[resource.Event[*ciliumv2.CiliumNode]]("auth gc-node-events", mapGC.handleCiliumNodeEvent, nodeChanges))
+func registerGCJobs(jobGroup job.Group, mapGC *authMapGarbageCollector, cfg config, identityChanges stream.Observable[cache.IdentityChange], m metrics.Metrics) {
+	// mapGC.handleIdentityChange and mapGC.cleanup both return the number of
+	// entries they evicted alongside their error, so the identity-change and
+	// periodic-cleanup reasons can be recorded here the same way node-event
+	// evictions are recorded directly in authNodeHandler. job.Observer and
+	// job.Timer only accept a single-error-return callback, so wrap each
+	// call in a closure that records the metric and forwards the error.
+	handleIdentityChange := func(ctx context.Context, ev cache.IdentityChange) error {
+		evicted, err := mapGC.handleIdentityChange(ctx, ev)
+		recordGCEviction(m, metrics.GCReasonIdentityChange, evicted)
+		return err
+	}
+	jobGroup.Add(job.Observer("auth gc-identity-events", handleIdentityChange, identityChanges))
+
+	// Node based auth gc is driven by the node manager subscription set up
+	// in registerAuthManager, which covers both local and remote (ClusterMesh)
+	// node deletions; eviction counts for it are recorded directly from
+	// authNodeHandler.
+
+	cleanup := func(ctx context.Context) error {
+		start := time.Now()
+		evicted, err := mapGC.cleanup(ctx)
+		m.GCDuration.Observe(time.Since(start).Seconds())
+		recordGCEviction(m, metrics.GCReasonPeriodicCleanup, evicted)
+		return err
 	}
+	jobGroup.Add(job.Timer("auth gc-cleanup", cleanup, cfg.MeshAuthGCInterval))
+}
 
-	jobGroup.Add(job.Timer("auth gc-cleanup", mapGC.cleanup, cfg.MeshAuthGCInterval))
+// recordGCEviction adds evicted to GCEntriesEvictedTotal under reason. Split
+// out of registerGCJobs's closures so the reason/metric wiring can be unit
+// tested without standing up a job.Group.
+func recordGCEviction(m metrics.Metrics, reason string, evicted int) {
+	m.GCEntriesEvictedTotal.WithLabelValues(reason).Add(float64(evicted))
 }
 
 type authHandlerResult struct {
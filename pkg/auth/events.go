@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package auth
+
+import (
+	"encoding/json"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/pkg/identity"
+	monitorAgent "github.com/cilium/cilium/pkg/monitor/agent"
+	"github.com/cilium/cilium/pkg/monitor/api"
+	"github.com/cilium/cilium/pkg/policy"
+)
+
+// authFailureEvent carries enough of a failed authentication attempt to let
+// an operator trace which identity pair failed and why, in the same
+// src/dst shape hubble flow events use elsewhere in the agent.
+type authFailureEvent struct {
+	AuthType     policy.AuthType
+	SrcIdentity  identity.NumericIdentity
+	DstIdentity  identity.NumericIdentity
+	RemoteNodeIP string
+	Error        string
+}
+
+// emitAuthFailureEvent logs a structured event for a failed authentication
+// and, when a monitor agent is available, publishes it as an
+// api.AgentNotifyGenericMessage so it shows up in hubble observe / cilium
+// monitor the same way other agent-side notifications do. monitor may
+// be nil (e.g. in tests, or builds that don't wire pkg/monitor/agent), in
+// which case the event is only logged.
+func emitAuthFailureEvent(logger logrus.FieldLogger, monitor monitorAgent.Agent, ev authFailureEvent) {
+	fields := logrus.Fields{
+		"authType":     ev.AuthType,
+		"srcIdentity":  ev.SrcIdentity,
+		"dstIdentity":  ev.DstIdentity,
+		"remoteNodeIP": ev.RemoteNodeIP,
+		"error":        ev.Error,
+	}
+	logger.WithFields(fields).Info("auth: authentication failed")
+
+	if monitor == nil {
+		return
+	}
+
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		logger.WithError(err).Warning("auth: failed to marshal authentication failure event for monitor notification")
+		return
+	}
+	notification := api.AgentNotify{Type: api.AgentNotifyGenericMessage, Text: string(payload)}
+	if err := monitor.SendEvent(api.MessageTypeAgent, notification); err != nil {
+		logger.WithError(err).Warning("auth: failed to send authentication failure notification to monitor agent")
+	}
+}
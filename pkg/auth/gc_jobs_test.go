@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	authMetrics "github.com/cilium/cilium/pkg/auth/metrics"
+)
+
+func TestRecordGCEviction(t *testing.T) {
+	m := authMetrics.NewMetrics()
+
+	recordGCEviction(m, authMetrics.GCReasonIdentityChange, 3)
+	recordGCEviction(m, authMetrics.GCReasonPeriodicCleanup, 2)
+	recordGCEviction(m, authMetrics.GCReasonIdentityChange, 1)
+
+	require.Equal(t, float64(4), testutil.ToFloat64(m.GCEntriesEvictedTotal.WithLabelValues(authMetrics.GCReasonIdentityChange)))
+	require.Equal(t, float64(2), testutil.ToFloat64(m.GCEntriesEvictedTotal.WithLabelValues(authMetrics.GCReasonPeriodicCleanup)))
+	require.Equal(t, float64(0), testutil.ToFloat64(m.GCEntriesEvictedTotal.WithLabelValues(authMetrics.GCReasonNodeEvent)))
+}
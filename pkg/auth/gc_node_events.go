@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package auth
+
+import (
+	nodeTypes "github.com/cilium/cilium/pkg/node/types"
+)
+
+// handleNodeDeleteEvent evicts every auth map entry whose remote-node-IP
+// matches one of node's addresses, and returns the number of entries
+// evicted, for metrics purposes. It is the node-manager backed counterpart
+// of handleCiliumNodeEvent: node manager node deletions originate both from
+// the local k8s.CiliumNodeResource and from remote clusters synced via
+// ClusterMesh, so this single handler covers both without needing to know
+// which cluster node belonged to.
+func (r *authMapGarbageCollector) handleNodeDeleteEvent(node nodeTypes.Node) int {
+	ips := make(map[string]struct{}, len(node.IPAddresses))
+	for _, addr := range node.IPAddresses {
+		if addr.IP == nil {
+			continue
+		}
+		ips[addr.IP.String()] = struct{}{}
+	}
+	if len(ips) == 0 {
+		return 0
+	}
+
+	return r.deleteEntriesForNodeIPs(ips)
+}
+
+// deleteEntriesForNodeIPs removes every cached auth map entry whose remote
+// node IP is present in ips, regardless of which cluster reported the node,
+// and returns the number of entries removed.
+func (r *authMapGarbageCollector) deleteEntriesForNodeIPs(ips map[string]struct{}) int {
+	return r.mapCache.deleteIf(func(key authKey) bool {
+		_, found := ips[key.remoteNodeIP.String()]
+		return found
+	})
+}
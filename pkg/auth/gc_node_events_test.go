@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package auth
+
+import (
+	"net"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+
+	authMetrics "github.com/cilium/cilium/pkg/auth/metrics"
+	"github.com/cilium/cilium/pkg/node/addressing"
+	nodeTypes "github.com/cilium/cilium/pkg/node/types"
+)
+
+// fakeDeleteIfCache is a minimal stand-in for authMapCache that only
+// implements deleteIf, which is all handleNodeDeleteEvent /
+// deleteEntriesForNodeIPs need from it.
+type fakeDeleteIfCache struct {
+	keys []authKey
+}
+
+func (f *fakeDeleteIfCache) deleteIf(match func(key authKey) bool) int {
+	kept := f.keys[:0]
+	evicted := 0
+	for _, k := range f.keys {
+		if match(k) {
+			evicted++
+			continue
+		}
+		kept = append(kept, k)
+	}
+	f.keys = kept
+	return evicted
+}
+
+func remoteClusterNode(clusterName, nodeName, ip string) nodeTypes.Node {
+	return nodeTypes.Node{
+		Name:    nodeName,
+		Cluster: clusterName,
+		IPAddresses: []nodeTypes.Address{
+			{
+				Type: addressing.NodeInternalIP,
+				IP:   net.ParseIP(ip),
+			},
+		},
+	}
+}
+
+func TestHandleNodeDeleteEvent_EvictsRemoteClusterNodeEntries(t *testing.T) {
+	cache := &fakeDeleteIfCache{
+		keys: []authKey{
+			{remoteNodeIP: net.ParseIP("10.1.2.3")}, // belongs to the deleted remote node
+			{remoteNodeIP: net.ParseIP("10.1.2.3")}, // a second entry for the same remote node IP
+			{remoteNodeIP: net.ParseIP("10.9.9.9")}, // unrelated entry, must survive
+		},
+	}
+	gc := &authMapGarbageCollector{mapCache: cache}
+
+	node := remoteClusterNode("remote-cluster", "remote-node-1", "10.1.2.3")
+
+	evicted := gc.handleNodeDeleteEvent(node)
+
+	require.Equal(t, 2, evicted)
+	require.Len(t, cache.keys, 1)
+	require.Equal(t, "10.9.9.9", cache.keys[0].remoteNodeIP.String())
+}
+
+func TestHandleNodeDeleteEvent_NoMatchingEntries(t *testing.T) {
+	cache := &fakeDeleteIfCache{
+		keys: []authKey{
+			{remoteNodeIP: net.ParseIP("10.9.9.9")},
+		},
+	}
+	gc := &authMapGarbageCollector{mapCache: cache}
+
+	node := remoteClusterNode("remote-cluster", "remote-node-2", "10.1.2.3")
+
+	evicted := gc.handleNodeDeleteEvent(node)
+
+	require.Equal(t, 0, evicted)
+	require.Len(t, cache.keys, 1)
+}
+
+func TestAuthNodeHandler_NodeDelete_RecordsEvictionMetric(t *testing.T) {
+	cache := &fakeDeleteIfCache{
+		keys: []authKey{
+			{remoteNodeIP: net.ParseIP("10.1.2.3")},
+		},
+	}
+	gc := &authMapGarbageCollector{mapCache: cache}
+	m := authMetrics.NewMetrics()
+	handler := newAuthNodeHandler(logrus.New(), gc, m)
+
+	node := remoteClusterNode("remote-cluster", "remote-node-1", "10.1.2.3")
+
+	require.NoError(t, handler.NodeDelete(node))
+	require.Empty(t, cache.keys)
+	require.Equal(t, float64(1), testutil.ToFloat64(m.GCEntriesEvictedTotal.WithLabelValues(authMetrics.GCReasonNodeEvent)))
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateClaims(t *testing.T) {
+	tests := []struct {
+		name                string
+		claims              jwt.RegisteredClaims
+		expectedIssuer      string
+		expectedSrcIdentity uint32
+		wantErr             bool
+	}{
+		{
+			name:                "matching subject, no issuer check",
+			claims:              jwt.RegisteredClaims{Subject: "1234"},
+			expectedSrcIdentity: 1234,
+		},
+		{
+			name:                "matching subject and issuer",
+			claims:              jwt.RegisteredClaims{Subject: "1234", Issuer: "https://idp.example.com"},
+			expectedIssuer:      "https://idp.example.com",
+			expectedSrcIdentity: 1234,
+		},
+		{
+			name:                "unexpected issuer",
+			claims:              jwt.RegisteredClaims{Subject: "1234", Issuer: "https://evil.example.com"},
+			expectedIssuer:      "https://idp.example.com",
+			expectedSrcIdentity: 1234,
+			wantErr:             true,
+		},
+		{
+			name:                "non-numeric subject",
+			claims:              jwt.RegisteredClaims{Subject: "not-a-number"},
+			expectedSrcIdentity: 1234,
+			wantErr:             true,
+		},
+		{
+			name:                "subject identity mismatch",
+			claims:              jwt.RegisteredClaims{Subject: "1234"},
+			expectedSrcIdentity: 5678,
+			wantErr:             true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateClaims(tt.claims, tt.expectedIssuer, tt.expectedSrcIdentity)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestJWKSValidator_EnsureJWKS_RequiresURI(t *testing.T) {
+	v := newJWKSValidator(JWTConfig{})
+
+	err := v.ensureJWKS()
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "auth-jwt-jwks-uri")
+
+	// ensureJWKS is idempotent: a second call must not panic or block on a
+	// real fetch once initOnce has already recorded the error.
+	err2 := v.ensureJWKS()
+	require.Equal(t, err, err2)
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+// Package metrics holds the Prometheus metrics for the auth subsystem
+// (pkg/auth). It is kept separate from pkg/auth itself so the metric
+// definitions can be depended on (and unit tested) without pulling in the
+// rest of the auth cell.
+package metrics
+
+import (
+	"github.com/cilium/cilium/pkg/hive/cell"
+	"github.com/cilium/cilium/pkg/metrics"
+	"github.com/cilium/cilium/pkg/metrics/metric"
+)
+
+// Cell provides the Metrics used to instrument the auth cell.
+var Cell = cell.Module(
+	"auth-metrics",
+	"Auth subsystem metrics",
+
+	cell.Provide(NewMetrics),
+)
+
+// GC eviction reasons, used as the "reason" label on GCEntriesEvictedTotal.
+const (
+	GCReasonIdentityChange  = "identity-change"
+	GCReasonNodeEvent       = "node-event"
+	GCReasonPeriodicCleanup = "periodic-cleanup"
+)
+
+// Metrics holds the Prometheus metrics published by the auth subsystem.
+type Metrics struct {
+	// SignalQueueSize is the current number of pending "auth required"
+	// signals buffered in the channel registered with the signal manager,
+	// compared against the configured MeshAuthQueueSize capacity.
+	SignalQueueSize metric.Gauge
+
+	// RequestsTotal counts authentication requests taken from the signal
+	// queue, labeled by the requested auth type and the outcome ("success"
+	// or "error"). Requests rejected for an unknown auth type, which never
+	// reach authManager.handleAuthRequest, are counted as "error".
+	RequestsTotal metric.Vec[metric.Counter]
+
+	// RequestDuration observes how long authenticating a single request
+	// took, labeled by auth type.
+	RequestDuration metric.Vec[metric.Observer]
+
+	// ReAuthenticationTotal counts re-authentications triggered by rotated
+	// identities (authManager.handleCertificateRotationEvent), labeled by
+	// auth type.
+	ReAuthenticationTotal metric.Vec[metric.Counter]
+
+	// GCDuration observes the wall-clock duration of a single
+	// mapGC.cleanup pass.
+	GCDuration metric.Observer
+
+	// GCEntriesEvictedTotal counts auth map entries evicted by garbage
+	// collection, labeled by reason: identity-change, node-event or
+	// periodic-cleanup.
+	GCEntriesEvictedTotal metric.Vec[metric.Counter]
+
+	// HandlerErrorsTotal counts errors returned by an authHandler while
+	// authenticating a request, labeled by auth type.
+	HandlerErrorsTotal metric.Vec[metric.Counter]
+
+	// UnknownAuthTypeErrorsTotal counts authentication requests rejected
+	// because no auth handler is registered for the requested auth type,
+	// labeled by that auth type.
+	UnknownAuthTypeErrorsTotal metric.Vec[metric.Counter]
+}
+
+// NewMetrics registers and returns the auth subsystem's metrics.
+func NewMetrics() Metrics {
+	return Metrics{
+		SignalQueueSize: metric.NewGauge(metric.GaugeOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "auth",
+			Name:      "queue_size",
+			Help:      "Number of pending auth required signals buffered in the auth manager's queue",
+		}),
+		RequestsTotal: metric.NewCounterVec(metric.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "auth",
+			Name:      "requests_total",
+			Help:      "Number of authentication requests processed, labeled by auth type and outcome",
+		}, []string{"auth_type", "outcome"}),
+		RequestDuration: metric.NewHistogramVec(metric.HistogramOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "auth",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of authenticating a single request, labeled by auth type",
+		}, []string{"auth_type"}),
+		ReAuthenticationTotal: metric.NewCounterVec(metric.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "auth",
+			Name:      "reauthentications_total",
+			Help:      "Number of re-authentications triggered by a rotated identity, labeled by auth type",
+		}, []string{"auth_type"}),
+		GCDuration: metric.NewHistogram(metric.HistogramOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "auth",
+			Name:      "gc_duration_seconds",
+			Help:      "Duration of a single auth map garbage collection pass",
+		}),
+		GCEntriesEvictedTotal: metric.NewCounterVec(metric.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "auth",
+			Name:      "gc_entries_evicted_total",
+			Help:      "Number of auth map entries evicted by garbage collection, labeled by reason",
+		}, []string{"reason"}),
+		HandlerErrorsTotal: metric.NewCounterVec(metric.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "auth",
+			Name:      "handler_errors_total",
+			Help:      "Number of errors returned by an auth handler, labeled by auth type",
+		}, []string{"auth_type"}),
+		UnknownAuthTypeErrorsTotal: metric.NewCounterVec(metric.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "auth",
+			Name:      "unknown_auth_type_errors_total",
+			Help:      "Number of authentication attempts rejected because no auth handler is registered for the requested auth type",
+		}, []string{"auth_type"}),
+	}
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package auth
+
+import (
+	"github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/pkg/auth/metrics"
+	datapath "github.com/cilium/cilium/pkg/datapath/types"
+	nodeTypes "github.com/cilium/cilium/pkg/node/types"
+)
+
+// authNodeHandler bridges node manager callbacks into the auth map garbage
+// collector. The node manager already aggregates nodes discovered locally
+// via k8s.CiliumNodeResource as well as nodes of remote clusters discovered
+// through the clustermesh-apiserver kvstore watchers, so subscribing to it
+// (instead of to k8s.CiliumNodeResource directly) is sufficient to make
+// mapGC.handleNodeDeleteEvent fire for remote-cluster nodes too.
+//
+// This replaces the previous CiliumNodes resource.Resource dependency, see
+// https://github.com/cilium/cilium/issues/25899.
+type authNodeHandler struct {
+	logger  logrus.FieldLogger
+	mapGC   *authMapGarbageCollector
+	metrics metrics.Metrics
+}
+
+func newAuthNodeHandler(logger logrus.FieldLogger, mapGC *authMapGarbageCollector, m metrics.Metrics) *authNodeHandler {
+	return &authNodeHandler{
+		logger:  logger,
+		mapGC:   mapGC,
+		metrics: m,
+	}
+}
+
+func (a *authNodeHandler) Name() string {
+	return "auth-node-handler"
+}
+
+func (a *authNodeHandler) NodeAdd(newNode nodeTypes.Node) error {
+	return nil
+}
+
+func (a *authNodeHandler) NodeUpdate(oldNode, newNode nodeTypes.Node) error {
+	return nil
+}
+
+// NodeDelete is invoked for both local-cluster and remote-cluster node
+// deletions. Remote-cluster nodes are deduplicated by the node manager on
+// cluster name + node name before reaching here, so every IP belonging to
+// node is safe to evict from the auth map.
+func (a *authNodeHandler) NodeDelete(node nodeTypes.Node) error {
+	a.logger.WithField("node", node.Fullname()).Debug("Handling node deletion for auth map garbage collection")
+	evicted := a.mapGC.handleNodeDeleteEvent(node)
+	a.metrics.GCEntriesEvictedTotal.WithLabelValues(metrics.GCReasonNodeEvent).Add(float64(evicted))
+	return nil
+}
+
+func (a *authNodeHandler) AllNodeValidateImplementation() {}
+
+func (a *authNodeHandler) NodeValidateImplementation(node nodeTypes.Node) error {
+	return nil
+}
+
+func (a *authNodeHandler) NodeConfigurationChanged(config datapath.LocalNodeConfiguration) error {
+	return nil
+}
+
+var _ datapath.NodeHandler = (*authNodeHandler)(nil)
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package auth
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/cilium/cilium/pkg/hive/cell"
+	"github.com/cilium/cilium/pkg/ipcache"
+	"github.com/cilium/cilium/pkg/policy"
+)
+
+// AuthHandlerParams is the subset of the auth cell's dependencies common to
+// every authHandler factory registered via RegisterAuthHandler. It is
+// deliberately narrower than authManagerParams, which carries internals
+// (job registry, lifecycle, ...) that only the auth manager itself should
+// see.
+//
+// A factory that needs additional, handler-specific dependencies (such as
+// newJWTAuthHandler's JWTConfig) should declare its own params struct
+// embedding cell.In rather than growing this one: AuthHandlerParams is
+// shared by every registered handler, and fields only one factory consumes
+// would otherwise accumulate here unused by the rest.
+type AuthHandlerParams struct {
+	cell.In
+
+	Logger     logrus.FieldLogger
+	IPCache    *ipcache.IPCache
+	PolicyRepo *policy.Repository
+}
+
+// AuthHandlerFactory constructs an authHandler, e.g. for a given
+// policy.AuthType, from a Params struct declared by the caller. It mirrors
+// the signature of the handler constructors already wired via
+// cell.ProvidePrivate in Cell (newMutualAuthHandler, newAlwaysFailAuthHandler),
+// generalized over Params so each factory can declare exactly the
+// dependencies it needs as its own cell.In struct.
+type AuthHandlerFactory[Params any] func(Params) (authHandler, error)
+
+// RegisterAuthHandler returns a cell that contributes an authHandler built
+// by factory to the "authHandlers" group consumed by the auth manager. It
+// lets additional cells add support for new policy.AuthType values without
+// editing this package's Cell definition. authHandler's methods are
+// unexported, so the handler itself can only be implemented inside package
+// auth; out-of-tree modules cannot provide one. name is used only to
+// annotate errors raised while constructing the handler. Params is whatever
+// cell.In struct factory needs; pass AuthHandlerParams for the common
+// dependencies, or a handler-specific struct that embeds it.
+//
+// Cell registers the jwt auth handler this way:
+//
+//	RegisterAuthHandler("jwt", newJWTAuthHandler)
+func RegisterAuthHandler[Params any](name string, factory AuthHandlerFactory[Params]) cell.Cell {
+	return cell.ProvidePrivate(func(params Params) (authHandlerResult, error) {
+		return buildAuthHandlerResult(name, factory, params)
+	})
+}
+
+// buildAuthHandlerResult runs factory with params and wraps the handler for
+// the "authHandlers" group, annotating a factory error with name.
+func buildAuthHandlerResult[Params any](name string, factory AuthHandlerFactory[Params], params Params) (authHandlerResult, error) {
+	h, err := factory(params)
+	if err != nil {
+		return authHandlerResult{}, fmt.Errorf("failed to construct auth handler %q: %w", name, err)
+	}
+	return authHandlerResult{AuthHandler: h}, nil
+}
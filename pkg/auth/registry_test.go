@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package auth
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildAuthHandlerResult_WrapsFactoryError(t *testing.T) {
+	factoryErr := errors.New("boom")
+	factory := AuthHandlerFactory[struct{}](func(struct{}) (authHandler, error) {
+		return nil, factoryErr
+	})
+
+	_, err := buildAuthHandlerResult("test-handler", factory, struct{}{})
+
+	require.Error(t, err)
+	require.ErrorIs(t, err, factoryErr)
+	require.Contains(t, err.Error(), `"test-handler"`)
+}
+
+func TestBuildAuthHandlerResult_ReturnsHandlerOnSuccess(t *testing.T) {
+	handler := &fakeAuthHandler{at: AuthTypeJWT}
+	factory := AuthHandlerFactory[struct{}](func(struct{}) (authHandler, error) {
+		return handler, nil
+	})
+
+	result, err := buildAuthHandlerResult("test-handler", factory, struct{}{})
+
+	require.NoError(t, err)
+	require.Same(t, handler, result.AuthHandler)
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package auth
+
+import (
+	"fmt"
+
+	"github.com/cilium/cilium/pkg/auth/metrics"
+	"github.com/cilium/cilium/pkg/policy"
+)
+
+// knownAuthTypes returns the set of policy.AuthType values that have a
+// registered authHandler.
+func knownAuthTypes(authHandlers []authHandler) map[policy.AuthType]struct{} {
+	types := make(map[policy.AuthType]struct{}, len(authHandlers))
+	for _, ah := range authHandlers {
+		if ah == nil {
+			continue
+		}
+		types[ah.authType()] = struct{}{}
+	}
+	return types
+}
+
+// rejectUnknownAuthType records m.UnknownAuthTypeErrorsTotal for authType
+// and returns an error suitable for returning to the caller of an auth
+// request for an auth type with no registered handler.
+func rejectUnknownAuthType(m metrics.Metrics, authType policy.AuthType) error {
+	m.UnknownAuthTypeErrorsTotal.WithLabelValues(authType.String()).Inc()
+	return fmt.Errorf("no auth handler registered for auth type %q", authType)
+}
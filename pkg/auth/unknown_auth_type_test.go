@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Cilium
+
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/cilium/pkg/auth/certs"
+	authMetrics "github.com/cilium/cilium/pkg/auth/metrics"
+	"github.com/cilium/cilium/pkg/policy"
+)
+
+// fakeAuthHandler is a minimal authHandler stand-in so knownAuthTypes can be
+// exercised without a real mutualAuthHandler or jwtAuthHandler.
+type fakeAuthHandler struct {
+	at policy.AuthType
+}
+
+func (f *fakeAuthHandler) authenticate(ctx context.Context, rawToken string, localIdentity uint32) (time.Duration, error) {
+	return 0, nil
+}
+
+func (f *fakeAuthHandler) authType() policy.AuthType {
+	return f.at
+}
+
+func (f *fakeAuthHandler) subscribeToRotatedIdentities() <-chan certs.CertificateRotationEvent {
+	return nil
+}
+
+func TestKnownAuthTypes(t *testing.T) {
+	otherAuthType := policy.AuthType(101)
+	handlers := []authHandler{
+		&fakeAuthHandler{at: otherAuthType},
+		&fakeAuthHandler{at: AuthTypeJWT},
+		nil, // A RegisterAuthHandler factory that returns a nil handler without an error puts nil into the group; knownAuthTypes skips it.
+	}
+
+	types := knownAuthTypes(handlers)
+
+	require.Len(t, types, 2)
+	_, ok := types[otherAuthType]
+	require.True(t, ok)
+	_, ok = types[AuthTypeJWT]
+	require.True(t, ok)
+	_, ok = types[policy.AuthType(102)]
+	require.False(t, ok)
+}
+
+func TestRejectUnknownAuthType(t *testing.T) {
+	m := authMetrics.NewMetrics()
+
+	err := rejectUnknownAuthType(m, AuthTypeJWT)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), AuthTypeJWT.String())
+}